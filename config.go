@@ -0,0 +1,44 @@
+package flume
+
+// Config is a serializable configuration for a Factory.  It is useful when
+// fully configuring the logging from an env var or file.
+//
+// The zero value for Config will set defaults for a standard, production logger.
+type Config struct {
+	// DefaultLevel is the level applied to loggers which aren't otherwise
+	// configured via Levels.
+	DefaultLevel Level
+
+	// Encoding selects the log encoder: one of "json", "ltsv", "term", or
+	// "term-color".  Defaults to "term-color" when Development is true,
+	// otherwise "ltsv".
+	Encoding      string
+	EncoderConfig *EncoderConfig
+
+	// Development enables settings more useful in a dev environment, like
+	// colorized, human-readable output and caller info.
+	Development bool
+
+	// AddCaller adds the logging callsite (file and line number) to log
+	// entries.  Defaults to the value of Development if unset.
+	AddCaller *bool
+
+	// Levels configures per-logger levels using the LevelsString grammar.
+	Levels string
+
+	// Sinks are additional logging destinations, each with its own encoder
+	// and level, fanned out to alongside the primary out/encoder.
+	Sinks []SinkConfig
+
+	// Sampling, if set, bounds log volume the way zap's production preset does.
+	Sampling *SamplingConfig
+
+	// StacktraceLevel is the level at or above which a stacktrace is attached
+	// to log entries, via zap.AddStacktrace.  Defaults to PanicLevel, or
+	// WarnLevel when Development is true.
+	StacktraceLevel *Level
+
+	// DisableStacktrace turns off stacktrace capture entirely, regardless of
+	// StacktraceLevel.
+	DisableStacktrace bool
+}
@@ -0,0 +1,76 @@
+package flume
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// atomicExtractors holds the current slice of registered context extractor
+// functions, swapped atomically so the logging hot path never has to take
+// Factory's mutex.
+type atomicExtractors struct {
+	v atomic.Value // []func(context.Context) []interface{}
+}
+
+func (a *atomicExtractors) get() []func(context.Context) []interface{} {
+	v, _ := a.v.Load().([]func(context.Context) []interface{})
+	return v
+}
+
+func (a *atomicExtractors) set(fns []func(context.Context) []interface{}) {
+	a.v.Store(fns)
+}
+
+// RegisterContextExtractor registers a function which pulls key/value pairs
+// out of a context.Context, e.g. a request ID or an OpenTelemetry span.  Every
+// registered extractor is consulted, in registration order, by the *Ctx
+// logging methods and by WithContext.
+func (r *Factory) RegisterContextExtractor(fn func(context.Context) []interface{}) {
+	r.Lock()
+	defer r.Unlock()
+	fns := append(append([]func(context.Context) []interface{}{}, r.contextExtractors.get()...), fn)
+	r.contextExtractors.set(fns)
+}
+
+// extractContext runs every registered context extractor against ctx and
+// concatenates the results.
+func (r *Factory) extractContext(ctx context.Context) []interface{} {
+	var fields []interface{}
+	for _, fn := range r.contextExtractors.get() {
+		fields = append(fields, fn(ctx)...)
+	}
+	return fields
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying l, retrievable later with FromContext.
+func NewContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the Logger stored in ctx by NewContext, or a disabled
+// no-op Logger if ctx doesn't carry one.
+func FromContext(ctx context.Context) Logger {
+	if l, ok := ctx.Value(contextKey{}).(Logger); ok {
+		return l
+	}
+	return noopLogger{}
+}
+
+// noopLogger is a Logger which discards everything.  It's returned by
+// FromContext when ctx doesn't carry a Logger, so callers don't need to nil-check.
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...interface{}) {}
+func (noopLogger) Info(string, ...interface{})  {}
+func (noopLogger) Error(string, ...interface{}) {}
+func (noopLogger) IsDebug() bool                { return false }
+func (noopLogger) IsInfo() bool                 { return false }
+func (noopLogger) With(...interface{}) Logger   { return noopLogger{} }
+
+func (noopLogger) DebugCtx(context.Context, string, ...interface{}) {}
+func (noopLogger) InfoCtx(context.Context, string, ...interface{})  {}
+func (noopLogger) ErrorCtx(context.Context, string, ...interface{}) {}
+
+func (noopLogger) WithContext(context.Context) Logger { return noopLogger{} }
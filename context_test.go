@@ -0,0 +1,40 @@
+package flume
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFromContextDefaultsToNoop(t *testing.T) {
+	l := FromContext(context.Background())
+	if l == nil {
+		t.Fatal("expected a non-nil Logger")
+	}
+	// Should not panic when no Logger was ever stored in the context.
+	l.Info("ignored")
+	l.DebugCtx(context.Background(), "ignored")
+}
+
+func TestNewContextRoundTrip(t *testing.T) {
+	f := NewFactory()
+	want := f.NewLogger("test")
+
+	ctx := NewContext(context.Background(), want)
+	if got := FromContext(ctx); got != want {
+		t.Error("FromContext did not return the Logger stored by NewContext")
+	}
+}
+
+type testRequestIDKey struct{}
+
+func TestRegisterContextExtractor(t *testing.T) {
+	f := NewFactory()
+	f.RegisterContextExtractor(func(ctx context.Context) []interface{} {
+		return []interface{}{"requestID", ctx.Value(testRequestIDKey{})}
+	})
+
+	fields := f.extractContext(context.WithValue(context.Background(), testRequestIDKey{}, "abc123"))
+	if len(fields) != 2 || fields[0] != "requestID" || fields[1] != "abc123" {
+		t.Errorf("extractContext() = %v, want [requestID abc123]", fields)
+	}
+}
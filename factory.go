@@ -28,6 +28,22 @@ type Factory struct {
 	encoder zapcore.Encoder
 	out     io.Writer
 
+	sinks map[string]Sink
+
+	// packageFields holds the default context for each logger registered via
+	// RegisterPackage or AutoLogger, keyed by name.
+	packageFields map[string][]interface{}
+	// commonFields is appended to every registered logger's context by
+	// UpdateAllLoggers.
+	commonFields []interface{}
+
+	sampling *SamplingConfig
+
+	contextExtractors atomicExtractors
+
+	stacktraceLevel   Level
+	disableStacktrace bool
+
 	loggers map[string]*loggerInfo
 	sync.Mutex
 
@@ -44,6 +60,7 @@ func NewFactory() *Factory {
 		loggers:      map[string]*loggerInfo{},
 	}
 	f.SetDefaultLevel(OffLevel)
+	f.SetStacktraceLevel(PanicLevel)
 
 	return &f
 }
@@ -76,6 +93,55 @@ func (r *Factory) SetOut(w io.Writer) func() {
 	}
 }
 
+// SetSampling sets the sampling policy applied to every logger created by
+// (in the past or future) this factory, bounding log volume the way zap's
+// production preset does.  Pass nil to disable sampling.
+func (r *Factory) SetSampling(cfg *SamplingConfig) {
+	r.Lock()
+	defer r.Unlock()
+	r.sampling = cfg
+	r.refreshLoggers()
+}
+
+// SetStacktraceLevel sets the level at or above which a stacktrace is
+// attached to log entries, via zap.AddStacktrace.
+func (r *Factory) SetStacktraceLevel(l Level) {
+	r.Lock()
+	defer r.Unlock()
+	r.stacktraceLevel = l
+	r.disableStacktrace = false
+	r.refreshLoggers()
+}
+
+// AddSink registers an additional logging destination under name.  Existing
+// and future loggers fan out to it alongside the factory's primary out/encoder,
+// until RemoveSink is called.  Adding a sink under a name which is already
+// registered replaces it.
+//
+// AddSink and RemoveSink never mutate a logger's live core in place: they
+// update r.sinks and call refreshLoggers, which rebuilds each logger's core
+// from scratch and swaps it into the logger's atomicLogger.  That swap is
+// already race-free, so the fanned-out core itself (see newLogger) can be a
+// plain zapcore.NewTee rather than something lock-guarded.
+func (r *Factory) AddSink(name string, s Sink) {
+	r.Lock()
+	defer r.Unlock()
+	if r.sinks == nil {
+		r.sinks = map[string]Sink{}
+	}
+	r.sinks[name] = s
+	r.refreshLoggers()
+}
+
+// RemoveSink removes a previously registered sink.  It is a no-op if name is
+// not registered.
+func (r *Factory) RemoveSink(name string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.sinks, name)
+	r.refreshLoggers()
+}
+
 // SetAddCaller enables adding the logging callsite (file and line number) to the log entries.
 func (r *Factory) SetAddCaller(b bool) {
 	r.Lock()
@@ -115,18 +181,42 @@ func (r *Factory) newLogger(name string, info *loggerInfo) *zap.SugaredLogger {
 	default:
 		l = r.defaultLevel
 	}
-	fac := zapcore.NewCore(
+
+	cores := make([]zapcore.Core, 0, len(r.sinks)+1)
+	cores = append(cores, zapcore.NewCore(
 		r.getEncoder(),
 		zapcore.AddSync(r.getOut()),
 		l,
-	)
+	))
+	for _, s := range r.sinks {
+		cores = append(cores, s.core())
+	}
+	// Safe to fan out with a plain NewTee: this core is rebuilt and swapped
+	// in atomically by refreshLoggers on every config change (see AddSink),
+	// never mutated while live, so no per-core locking is needed here.
+	var core zapcore.Core = zapcore.NewTee(cores...)
+	if r.sampling != nil {
+		core = zapcore.NewSamplerWithOptions(core, r.sampling.tick(), r.sampling.Initial, r.sampling.Thereafter)
+	}
 
 	opts := []zap.Option{zap.AddCallerSkip(1)}
 
 	if r.addCaller {
 		opts = append(opts, zap.AddCaller())
 	}
-	return zap.New(fac, opts...).Named(name).Sugar()
+	if !r.disableStacktrace {
+		opts = append(opts, zap.AddStacktrace(zapcore.Level(r.stacktraceLevel)))
+	}
+	sl := zap.New(core, opts...).Named(name).Sugar()
+	if fields, registered := r.packageFields[name]; registered {
+		if len(fields) > 0 {
+			sl = sl.With(fields...)
+		}
+		if len(r.commonFields) > 0 {
+			sl = sl.With(r.commonFields...)
+		}
+	}
+	return sl
 }
 
 // NewDeprecatedLogger returns a new DeprecatedLogger.
@@ -136,6 +226,7 @@ func (r *Factory) NewDeprecatedLogger(name string) DeprecatedLogger {
 	info := r.getLoggerInfo(name)
 	return &logger{
 		atomicLogger: &info.atomicLogger,
+		factory:      r,
 	}
 }
 
@@ -216,7 +307,7 @@ func (r *Factory) LevelsString(s string) error {
 		case string:
 			l, err := levelForAbbr(t)
 			levelMap[key] = l
-			if err != nil {
+			if err != nil && isUnrecognizedLevel(err) {
 				errMsgs = append(errMsgs, err.Error())
 			}
 		}
@@ -311,13 +402,34 @@ func (r *Factory) Configure(cfg Config) error {
 		addCaller = cfg.Development
 	}
 
+	sinks := map[string]Sink{}
+	for _, sc := range cfg.Sinks {
+		sink, err := sc.build()
+		if err != nil {
+			return err
+		}
+		sinks[sc.Name] = sink
+	}
+
 	// todo: break up LevelsString into parse and apply phases, so I
 	// can avoid taking the lock twice
 	r.LevelsString(cfg.Levels)
+	stacktraceLevel := PanicLevel
+	if cfg.Development {
+		stacktraceLevel = WarnLevel
+	}
+	if cfg.StacktraceLevel != nil {
+		stacktraceLevel = *cfg.StacktraceLevel
+	}
+
 	r.Lock()
 	defer r.Unlock()
 	r.encoder = encoder
 	r.addCaller = addCaller
+	r.sinks = sinks
+	r.sampling = cfg.Sampling
+	r.stacktraceLevel = stacktraceLevel
+	r.disableStacktrace = cfg.DisableStacktrace
 	r.refreshLoggers()
 	return nil
 }
@@ -343,6 +455,26 @@ func levelForAbbr(abbr string) (Level, error) {
 	case "ftl", "fatal":
 		return PanicLevel, errors.New("FTL is deprecated, use ERR, mapped to PANIC")
 	default:
-		return WarnLevel, fmt.Errorf("%s not recognized level, defaulting to warn", abbr)
+		return WarnLevel, errUnrecognizedLevel{abbr: abbr}
 	}
 }
+
+// errUnrecognizedLevel marks a levelForAbbr failure that couldn't be mapped to
+// any valid Level at all.  It's distinct from the other errors levelForAbbr
+// returns, which just flag a deprecated-but-still-valid abbreviation (the
+// returned Level is usable either way).
+type errUnrecognizedLevel struct {
+	abbr string
+}
+
+func (e errUnrecognizedLevel) Error() string {
+	return fmt.Sprintf("%s not recognized level, defaulting to warn", e.abbr)
+}
+
+// isUnrecognizedLevel reports whether err signals a genuinely-unparseable
+// level abbreviation, as opposed to a deprecation notice for an abbreviation
+// that was still mapped to a valid Level.
+func isUnrecognizedLevel(err error) bool {
+	_, ok := err.(errUnrecognizedLevel)
+	return ok
+}
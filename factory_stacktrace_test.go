@@ -0,0 +1,49 @@
+package flume
+
+import "testing"
+
+func TestConfigureStacktraceLevelDefaults(t *testing.T) {
+	cases := []struct {
+		name string
+		cfg  Config
+		want Level
+	}{
+		{"production default", Config{}, PanicLevel},
+		{"development default", Config{Development: true}, WarnLevel},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			f := NewFactory()
+			if err := f.Configure(c.cfg); err != nil {
+				t.Fatalf("Configure: %s", err)
+			}
+			if f.stacktraceLevel != c.want {
+				t.Errorf("stacktraceLevel = %v, want %v", f.stacktraceLevel, c.want)
+			}
+		})
+	}
+}
+
+func TestConfigureDisableStacktrace(t *testing.T) {
+	f := NewFactory()
+	if err := f.Configure(Config{DisableStacktrace: true}); err != nil {
+		t.Fatalf("Configure: %s", err)
+	}
+	if !f.disableStacktrace {
+		t.Error("Configure should honor Config.DisableStacktrace")
+	}
+}
+
+func TestSetStacktraceLevelReenablesStacktrace(t *testing.T) {
+	f := NewFactory()
+	f.disableStacktrace = true
+
+	f.SetStacktraceLevel(ErrorLevel)
+
+	if f.disableStacktrace {
+		t.Error("SetStacktraceLevel should re-enable stacktrace capture")
+	}
+	if f.stacktraceLevel != ErrorLevel {
+		t.Errorf("stacktraceLevel = %v, want %v", f.stacktraceLevel, ErrorLevel)
+	}
+}
@@ -0,0 +1,139 @@
+package flume
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// levelsResponse is the JSON wire format returned by GET requests to
+// Factory.ServeHTTP.
+type levelsResponse struct {
+	Default string            `json:"default"`
+	Loggers map[string]string `json:"loggers,omitempty"`
+}
+
+// levelsRequest is the JSON wire format accepted by PUT/POST requests to
+// Factory.ServeHTTP.
+type levelsRequest struct {
+	Default string            `json:"default,omitempty"`
+	Loggers map[string]string `json:"loggers,omitempty"`
+}
+
+// LevelsHandler returns an http.Handler with the same behavior as ServeHTTP,
+// for mounting under a custom path with the standard net/http mux.
+func (r *Factory) LevelsHandler() http.Handler {
+	return http.HandlerFunc(r.ServeHTTP)
+}
+
+// ServeHTTP implements a small admin protocol for live level reconfiguration,
+// so operators can tweak verbosity in production without a restart.
+//
+// GET returns the current default level plus the level of every named
+// logger, as JSON.
+//
+// PUT and POST accept either the LevelsString grammar (`*=INF,sql=DBG,-http`)
+// as a plain text body, or a JSON body of the form
+// `{"default":"info","loggers":{"sql":"debug","http":"off"}}`, and apply it
+// via SetDefaultLevel/SetLevel.
+func (r *Factory) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		r.serveGetLevels(w)
+	case http.MethodPut, http.MethodPost:
+		r.servePutLevels(w, req)
+	default:
+		w.Header().Set("Allow", "GET, PUT, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (r *Factory) serveGetLevels(w http.ResponseWriter) {
+	r.Lock()
+	resp := levelsResponse{
+		Default: Level(r.defaultLevel.Level()).String(),
+		Loggers: make(map[string]string, len(r.loggers)),
+	}
+	for name, info := range r.loggers {
+		resp.Loggers[name] = effectiveLevel(r.defaultLevel, info).String()
+	}
+	r.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (r *Factory) servePutLevels(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer req.Body.Close()
+
+	contentType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if contentType == "application/json" {
+		var lr levelsRequest
+		if err := json.Unmarshal(body, &lr); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var errMsgs []string
+		var defaultLevel Level
+		hasDefault := lr.Default != ""
+		if hasDefault {
+			lvl, err := levelForAbbr(lr.Default)
+			if err != nil && isUnrecognizedLevel(err) {
+				errMsgs = append(errMsgs, err.Error())
+			}
+			defaultLevel = lvl
+		}
+		loggerLevels := make(map[string]Level, len(lr.Loggers))
+		for name, abbr := range lr.Loggers {
+			lvl, err := levelForAbbr(abbr)
+			if err != nil && isUnrecognizedLevel(err) {
+				errMsgs = append(errMsgs, err.Error())
+			}
+			loggerLevels[name] = lvl
+		}
+		if len(errMsgs) > 0 {
+			http.Error(w, "errors parsing levels: "+strings.Join(errMsgs, ", "), http.StatusBadRequest)
+			return
+		}
+
+		// Only apply once every entry in the request has parsed cleanly, so a
+		// bad request can't partially reconfigure the factory.
+		if hasDefault {
+			r.SetDefaultLevel(defaultLevel)
+		}
+		for name, lvl := range loggerLevels {
+			r.SetLevel(name, lvl)
+		}
+	} else if err := r.LevelsString(string(body)); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	r.serveGetLevels(w)
+}
+
+// effectiveLevel resolves the Level a logger is actually running at, whether
+// it was left to track the factory's default or pinned via SetLevel.
+func effectiveLevel(defaultLevel zap.AtomicLevel, info *loggerInfo) Level {
+	switch t := info.levelEnabler.(type) {
+	case nil:
+		return Level(defaultLevel.Level())
+	case zapcore.Level:
+		return Level(t)
+	case zap.AtomicLevel:
+		return Level(t.Level())
+	default:
+		return Level(defaultLevel.Level())
+	}
+}
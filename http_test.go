@@ -0,0 +1,82 @@
+package flume
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestEffectiveLevel(t *testing.T) {
+	def := zap.NewAtomicLevel()
+	def.SetLevel(zapcore.WarnLevel)
+
+	cases := []struct {
+		name string
+		info *loggerInfo
+		want Level
+	}{
+		{"nil enabler tracks default", &loggerInfo{}, WarnLevel},
+		{"concrete override", &loggerInfo{levelEnabler: zapcore.DebugLevel}, DebugLevel},
+		{"shared default atomic level", &loggerInfo{levelEnabler: def}, WarnLevel},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := effectiveLevel(def, c.info); got != c.want {
+				t.Errorf("effectiveLevel() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestServePutLevelsRejectsPartialBadRequest(t *testing.T) {
+	f := NewFactory()
+	f.SetDefaultLevel(InfoLevel)
+	f.SetLevel("sql", DebugLevel)
+
+	body := `{"default":"warn","loggers":{"sql":"bogus"}}`
+	req := httptest.NewRequest(http.MethodPut, "/levels", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	f.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", w.Code)
+	}
+	if f.defaultLevel.Level() != zapcore.InfoLevel {
+		t.Errorf("default level should be left unchanged by a partially invalid request, got %v", f.defaultLevel.Level())
+	}
+}
+
+func TestServePutLevelsAcceptsDeprecatedAbbreviations(t *testing.T) {
+	cases := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{"json", "application/json", `{"default":"warn"}`},
+		{"text", "text/plain", `*=warn`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			f := NewFactory()
+
+			req := httptest.NewRequest(http.MethodPut, "/levels", strings.NewReader(c.body))
+			req.Header.Set("Content-Type", c.contentType)
+			w := httptest.NewRecorder()
+
+			f.ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected 200 for a deprecated-but-valid abbreviation, got %d: %s", w.Code, w.Body.String())
+			}
+			if f.defaultLevel.Level() != zapcore.WarnLevel {
+				t.Errorf("default level = %v, want WarnLevel", f.defaultLevel.Level())
+			}
+		})
+	}
+}
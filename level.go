@@ -0,0 +1,30 @@
+package flume
+
+import "go.uber.org/zap/zapcore"
+
+// Level is a log severity level.  It is a direct analog of zapcore.Level,
+// extended with AllLevel and OffLevel sentinels so LevelEnablers can express
+// "log everything" and "log nothing" without special-casing the zap levels.
+type Level zapcore.Level
+
+const (
+	// AllLevel enables every log entry, including Debug.
+	AllLevel   Level = Level(zapcore.DebugLevel - 1)
+	DebugLevel Level = Level(zapcore.DebugLevel)
+	InfoLevel  Level = Level(zapcore.InfoLevel)
+	WarnLevel  Level = Level(zapcore.WarnLevel)
+	ErrorLevel Level = Level(zapcore.ErrorLevel)
+	PanicLevel Level = Level(zapcore.PanicLevel)
+	// OffLevel disables all log entries.
+	OffLevel Level = Level(zapcore.PanicLevel + 1)
+)
+
+// Enabled implements zapcore.LevelEnabler, so a bare Level can be used
+// anywhere a LevelEnabler is expected.
+func (l Level) Enabled(lvl zapcore.Level) bool {
+	return zapcore.Level(l).Enabled(lvl)
+}
+
+func (l Level) String() string {
+	return zapcore.Level(l).String()
+}
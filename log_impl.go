@@ -1,6 +1,7 @@
 package flume
 
 import (
+	"context"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"sync/atomic"
@@ -23,6 +24,9 @@ func (af *atomicLogger) set(logger *zap.SugaredLogger) {
 type logger struct {
 	*atomicLogger
 	context []interface{}
+	// factory is the Factory this logger was spawned from, used to resolve
+	// context extractors registered via Factory.RegisterContextExtractor.
+	factory *Factory
 }
 
 // Trace is an alias for Debug.  Here for API compatibility with logxi
@@ -94,6 +98,55 @@ func (l *logger) Fatal(msg string, args ...interface{}) {
 	}
 }
 
+// DebugCtx logs at DBG level, prepending key/value pairs produced by any
+// context extractors registered via Factory.RegisterContextExtractor.
+func (l *logger) DebugCtx(ctx context.Context, msg string, args ...interface{}) {
+	l.get().Debugw(msg, l.ctxArgs(ctx, args)...)
+}
+
+// InfoCtx logs at INF level, prepending key/value pairs produced by any
+// context extractors registered via Factory.RegisterContextExtractor.
+func (l *logger) InfoCtx(ctx context.Context, msg string, args ...interface{}) {
+	l.get().Infow(msg, l.ctxArgs(ctx, args)...)
+}
+
+// WarnCtx logs at WRN level, prepending key/value pairs produced by any
+// context extractors registered via Factory.RegisterContextExtractor.
+// deprecated: use InfoCtx.
+func (l *logger) WarnCtx(ctx context.Context, msg string, args ...interface{}) {
+	l.get().Warnw(msg, l.ctxArgs(ctx, args)...)
+}
+
+// ErrorCtx logs at ERR level, prepending key/value pairs produced by any
+// context extractors registered via Factory.RegisterContextExtractor.
+func (l *logger) ErrorCtx(ctx context.Context, msg string, args ...interface{}) {
+	l.get().Errorw(msg, l.ctxArgs(ctx, args)...)
+}
+
+// ctxArgs assembles the final key/value list for a *Ctx call: the logger's
+// baked-in context, then any fields extracted from ctx, then the call's args.
+func (l *logger) ctxArgs(ctx context.Context, args []interface{}) []interface{} {
+	all := append([]interface{}{}, l.context...)
+	if l.factory != nil {
+		all = append(all, l.factory.extractContext(ctx)...)
+	}
+	return append(all, normalizeArgs(args)...)
+}
+
+// WithContext returns a new Logger with the fields produced by any registered
+// context extractors baked in, the same way With bakes in explicit key/value
+// pairs.
+func (l *logger) WithContext(ctx context.Context) Logger {
+	if l.factory == nil {
+		return l
+	}
+	fields := l.factory.extractContext(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return l.With(fields...)
+}
+
 // IsDebug returns true if DBG level is enabled.
 func (l *logger) IsDebug() bool {
 	return l.get().Desugar().Core().Enabled(zap.DebugLevel)
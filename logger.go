@@ -0,0 +1,55 @@
+package flume
+
+import "context"
+
+// Logger is the primary logging interface returned by Factory.NewLogger.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+	IsDebug() bool
+	IsInfo() bool
+
+	// With returns a new Logger with some context baked in.  All entries
+	// logged with the new logger will include this context.
+	With(args ...interface{}) Logger
+
+	// DebugCtx, InfoCtx, and ErrorCtx behave like their non-Ctx counterparts,
+	// but additionally prepend key/value pairs produced by any extractor
+	// functions registered with Factory.RegisterContextExtractor.
+	DebugCtx(ctx context.Context, msg string, args ...interface{})
+	InfoCtx(ctx context.Context, msg string, args ...interface{})
+	ErrorCtx(ctx context.Context, msg string, args ...interface{})
+
+	// WithContext returns a new Logger with the fields produced by any
+	// registered context extractors baked in, the same way With bakes in
+	// explicit key/value pairs.
+	WithContext(ctx context.Context) Logger
+}
+
+// DeprecatedLogger extends Logger with logxi-compatible methods.  These are
+// kept for API compatibility with code ported from logxi, but new code should
+// prefer the plain Logger interface.
+type DeprecatedLogger interface {
+	Logger
+
+	// Trace is an alias for Debug.
+	// deprecated: use Debug
+	Trace(msg string, args ...interface{})
+	// Warn logs at WRN level.
+	// deprecated: use Info
+	Warn(msg string, args ...interface{})
+	// Fatal logs at PNC level, and will cause a panic after logging.
+	// deprecated: use Error
+	Fatal(msg string, args ...interface{})
+	// IsTrace returns true if DBG level is enabled.
+	// deprecated: use IsDebug
+	IsTrace() bool
+	// IsWarn returns true if WRN level is enabled.
+	// deprecated: use IsInfo
+	IsWarn() bool
+	// WarnCtx behaves like Warn, but additionally prepends key/value pairs
+	// produced by any registered context extractors.
+	// deprecated: use InfoCtx
+	WarnCtx(ctx context.Context, msg string, args ...interface{})
+}
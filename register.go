@@ -0,0 +1,100 @@
+package flume
+
+import (
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// RegisterPackage registers (or re-registers) a Logger for name, with
+// defaultLevel and defaultFields baked in as context on every entry it logs.
+// It's modeled on voltha's log.RegisterPackage, for libraries that want a
+// single named logger per package without threading one through every call.
+func (r *Factory) RegisterPackage(name string, defaultLevel Level, defaultFields ...interface{}) Logger {
+	r.Lock()
+	defer r.Unlock()
+	if r.packageFields == nil {
+		r.packageFields = map[string][]interface{}{}
+	}
+	r.packageFields[name] = normalizeArgs(append([]interface{}{}, defaultFields...))
+	r.setLevel(name, defaultLevel)
+	info := r.getLoggerInfo(name)
+	r.refreshLoggers()
+	return &logger{atomicLogger: &info.atomicLogger, factory: r}
+}
+
+// UpdateAllLoggers appends fields to the context of every logger registered
+// via RegisterPackage or AutoLogger.  The update is applied by rebuilding each
+// registered logger's underlying *zap.SugaredLogger and swapping it in via
+// the same atomicLogger.set used by refreshLoggers, so callers already
+// holding a Logger see the new fields on their next log call.
+func (r *Factory) UpdateAllLoggers(fields ...interface{}) {
+	r.Lock()
+	defer r.Unlock()
+	r.commonFields = append(r.commonFields, normalizeArgs(fields)...)
+	r.refreshLoggers()
+}
+
+// RegisteredLoggers returns the names of all loggers registered via
+// RegisterPackage or AutoLogger, for diagnostics.
+func (r *Factory) RegisteredLoggers() []string {
+	r.Lock()
+	defer r.Unlock()
+	names := make([]string, 0, len(r.packageFields))
+	for name := range r.packageFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AutoLogger returns the Logger registered for the calling function's
+// package, creating it (with no default fields, and no level of its own, so
+// it tracks the factory's default level) if this is the first call for that
+// package.  This lets a library log via
+// `r.AutoLogger().Info(...)` without naming its own package.
+func (r *Factory) AutoLogger() Logger {
+	name := callerPackage(2)
+
+	r.Lock()
+	defer r.Unlock()
+	if r.packageFields == nil {
+		r.packageFields = map[string][]interface{}{}
+	}
+	if _, found := r.packageFields[name]; !found {
+		r.packageFields[name] = nil
+	}
+	info := r.getLoggerInfo(name)
+	return &logger{atomicLogger: &info.atomicLogger, factory: r}
+}
+
+// callerPackage derives the import path of the function skip frames up the
+// stack from callerPackage itself, using runtime.Caller rather than
+// reflection.
+func callerPackage(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip)
+	if !ok {
+		return "unknown"
+	}
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return "unknown"
+	}
+	return packagePathFromFuncName(fn.Name())
+}
+
+// packagePathFromFuncName strips the function/method name from a fully
+// qualified runtime function name, e.g.
+// "github.com/foo/bar.(*Thing).Method" -> "github.com/foo/bar", and
+// "github.com/foo/bar.Func.func1" -> "github.com/foo/bar".  The package
+// name ends at the first dot after the last slash; everything past that may
+// itself contain dots (methods, closures), so it can't be found with a bare
+// LastIndex(".").
+func packagePathFromFuncName(full string) string {
+	lastSlash := strings.LastIndex(full, "/")
+	dot := strings.Index(full[lastSlash+1:], ".")
+	if dot < 0 {
+		return full
+	}
+	return full[:lastSlash+1+dot]
+}
@@ -0,0 +1,25 @@
+package flume
+
+import "testing"
+
+func TestPackagePathFromFuncName(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain func", "github.com/foo/bar.Func", "github.com/foo/bar"},
+		{"method", "github.com/foo/bar.(*Thing).Method", "github.com/foo/bar"},
+		{"closure", "github.com/foo/bar.Func.func1", "github.com/foo/bar"},
+		{"method closure", "github.com/foo/bar.(*Thing).Method.func1", "github.com/foo/bar"},
+		{"nested package", "github.com/foo/bar/baz.Func", "github.com/foo/bar/baz"},
+		{"no slash", "main.main", "main"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := packagePathFromFuncName(c.in); got != c.want {
+				t.Errorf("packagePathFromFuncName(%q) = %q, want %q", c.in, got, c.want)
+			}
+		})
+	}
+}
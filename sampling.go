@@ -0,0 +1,21 @@
+package flume
+
+import "time"
+
+// SamplingConfig bounds log volume the way zap's production preset does: of
+// the entries logged at a given level+message within each Tick interval, the
+// first Initial are logged, then every Thereafter-th one after that.
+type SamplingConfig struct {
+	Initial    int
+	Thereafter int
+	// Tick is the interval over which Initial/Thereafter are counted.
+	// Defaults to one second, matching zap's production preset.
+	Tick time.Duration
+}
+
+func (sc *SamplingConfig) tick() time.Duration {
+	if sc.Tick <= 0 {
+		return time.Second
+	}
+	return sc.Tick
+}
@@ -0,0 +1,18 @@
+package flume
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSamplingConfigTick(t *testing.T) {
+	var sc SamplingConfig
+	if got := sc.tick(); got != time.Second {
+		t.Errorf("zero Tick should default to 1s, got %s", got)
+	}
+
+	sc.Tick = 5 * time.Second
+	if got := sc.tick(); got != 5*time.Second {
+		t.Errorf("tick() = %s, want 5s", got)
+	}
+}
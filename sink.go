@@ -0,0 +1,130 @@
+package flume
+
+import (
+	"io"
+	"os"
+
+	"github.com/ansel1/merry"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Rotation configures log file rotation for a Sink backed by a file on disk.
+// It is wired into gopkg.in/natefinch/lumberjack.v2.
+type Rotation struct {
+	// Filename is the file to write logs to.
+	Filename string
+	// MaxSize is the maximum size in megabytes of the log file before it gets rotated.
+	MaxSize int
+	// MaxBackups is the maximum number of old log files to retain.
+	MaxBackups int
+	// MaxAge is the maximum number of days to retain old log files.
+	MaxAge int
+	// Compress determines if rotated log files should be compressed using gzip.
+	Compress bool
+}
+
+func (r *Rotation) writer() io.Writer {
+	return &lumberjack.Logger{
+		Filename:   r.Filename,
+		MaxSize:    r.MaxSize,
+		MaxBackups: r.MaxBackups,
+		MaxAge:     r.MaxAge,
+		Compress:   r.Compress,
+	}
+}
+
+// Sink is a single additional logging destination, with its own writer,
+// encoder, and level.  A Factory fans each log entry out to its primary
+// out/encoder plus every registered Sink.  Out defaults to os.Stdout and
+// Level to AllLevel when left zero; Encoder defaults to the same LTSV
+// encoder Factory itself falls back to when unset.
+type Sink struct {
+	Out     io.Writer
+	Encoder Encoder
+	Level   zapcore.LevelEnabler
+}
+
+func (s Sink) core() zapcore.Core {
+	level := s.Level
+	if level == nil {
+		level = AllLevel
+	}
+	out := s.Out
+	if out == nil {
+		out = os.Stdout
+	}
+	encoder := s.Encoder
+	if encoder == nil {
+		encoder = NewLTSVEncoder(NewEncoderConfig())
+	}
+	return zapcore.NewCore(encoder, zapcore.AddSync(out), level)
+}
+
+// SinkConfig is the serializable configuration for a Sink, suitable for
+// embedding in Config.
+type SinkConfig struct {
+	// Name identifies the sink, for later RemoveSink calls.
+	Name string
+
+	// Encoding selects the sink's encoder: one of "json", "ltsv", "term", or
+	// "term-color".  Defaults to "json".
+	Encoding      string
+	EncoderConfig *EncoderConfig
+
+	// Level is the minimum level this sink will accept.  Defaults to AllLevel
+	// when nil.
+	Level *Level
+
+	// Rotation, if set, writes the sink's output to a rotating log file
+	// instead of stdout.
+	Rotation *Rotation
+}
+
+func (sc SinkConfig) build() (Sink, error) {
+	var encCfg *EncoderConfig
+	if sc.EncoderConfig != nil {
+		// Copy rather than mutate the caller's EncoderConfig in place below,
+		// since it may be shared with other sinks or reused across calls.
+		cfgCopy := *sc.EncoderConfig
+		encCfg = &cfgCopy
+	} else {
+		encCfg = NewEncoderConfig()
+	}
+	if encCfg.EncodeCaller == nil {
+		encCfg.EncodeCaller = zapcore.ShortCallerEncoder
+	}
+	if encCfg.EncodeLevel == nil {
+		encCfg.EncodeLevel = AbbrLevelEncoder
+	}
+
+	var encoder zapcore.Encoder
+	switch sc.Encoding {
+	case "json", "":
+		encoder = NewJSONEncoder(encCfg)
+	case "ltsv":
+		encoder = NewLTSVEncoder(encCfg)
+	case "term":
+		encoder = NewConsoleEncoder(encCfg)
+	case "term-color":
+		encoder = NewColorizedConsoleEncoder(encCfg, nil)
+	default:
+		return Sink{}, merry.Errorf("%s is not a valid sink encoding, must be one of: json, ltsv, term, or term-color", sc.Encoding)
+	}
+
+	var out io.Writer = os.Stdout
+	if sc.Rotation != nil {
+		out = sc.Rotation.writer()
+	}
+
+	level := AllLevel
+	if sc.Level != nil {
+		level = *sc.Level
+	}
+
+	return Sink{
+		Out:     out,
+		Encoder: encoder,
+		Level:   zapcore.Level(level),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package flume
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+func TestSinkCoreDefaults(t *testing.T) {
+	core := Sink{}.core()
+	if core == nil {
+		t.Fatal("expected a non-nil core")
+	}
+	if !core.Enabled(zapcore.DebugLevel) {
+		t.Error("zero-value Sink should default to AllLevel, enabling Debug")
+	}
+}
+
+func TestSinkConfigBuildDefaultsLevel(t *testing.T) {
+	sink, err := SinkConfig{}.build()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !sink.Level.Enabled(zapcore.DebugLevel) {
+		t.Error("SinkConfig with an unset Level should build a sink that defaults to AllLevel")
+	}
+
+	warn := WarnLevel
+	sink, err = SinkConfig{Level: &warn}.build()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if sink.Level.Enabled(zapcore.InfoLevel) {
+		t.Error("SinkConfig.Level should override the AllLevel default when set")
+	}
+}
+
+func TestSinkCoreDefaultsEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	core := Sink{Out: &buf}.core()
+	if err := core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Message: "hello"}, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if buf.Len() == 0 {
+		t.Fatal("expected a Sink with no Encoder to build a working core instead of panicking on a nil encoder")
+	}
+}
+
+func TestSinkConfigBuildDoesNotMutateCallerEncoderConfig(t *testing.T) {
+	encCfg := NewEncoderConfig()
+
+	if _, err := (SinkConfig{EncoderConfig: encCfg}).build(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if encCfg.EncodeCaller != nil || encCfg.EncodeLevel != nil {
+		t.Error("SinkConfig.build should not mutate the caller's EncoderConfig in place")
+	}
+}